@@ -0,0 +1,47 @@
+package enterl2
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// BridgeService provides access to L1<->L2 bridging operations.
+type BridgeService struct {
+	client *client
+}
+
+// NewBridgeService creates a new bridge service bound to client.
+func NewBridgeService(c *client) *BridgeService {
+	return &BridgeService{client: c}
+}
+
+func init() {
+	selector := revertSelector("InsufficientLiquidity(uint256)")
+	RegisterRevertSelector(selector, decodeInsufficientLiquidity)
+}
+
+// revertSelector computes the 4-byte selector for a Solidity custom error
+// or function signature, matching how go-ethereum's abi package derives
+// method IDs.
+func revertSelector(signature string) [4]byte {
+	var selector [4]byte
+	copy(selector[:], crypto.Keccak256([]byte(signature))[:4])
+	return selector
+}
+
+// decodeInsufficientLiquidity decodes the Bridge's
+// InsufficientLiquidity(uint256 available) custom revert error.
+func decodeInsufficientLiquidity(args []byte) (string, interface{}, error) {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return "", nil, err
+	}
+	values, err := abi.Arguments{{Type: uint256Type}}.Unpack(args)
+	if err != nil {
+		return "", nil, err
+	}
+	available := values[0].(*big.Int)
+	return "insufficient bridge liquidity", available, nil
+}
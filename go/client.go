@@ -6,6 +6,7 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -13,25 +14,65 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/shopspring/decimal"
 )
 
-// Client represents the main Enter L2 client
-type Client struct {
+// Client is the interface implemented by the Enter L2 SDK client. It is
+// satisfied by the unexported client type returned from NewClient and
+// NewClientWithBackend, and can be faked (see the mockclient subpackage)
+// for unit testing downstream SDK consumers.
+type Client interface {
+	Connect(privateKeyHex string) error
+	GetAddress() common.Address
+	IsConnected() bool
+	GetBalance(ctx context.Context, tokenAddress *common.Address) (*big.Int, error)
+	GetTransaction(ctx context.Context, hash common.Hash) (*TransactionResponse, error)
+	WaitForTransaction(ctx context.Context, hash common.Hash, confirmations uint64) (*TransactionResponse, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) (common.Hash, error)
+	GetTransactOpts(ctx context.Context) (*bind.TransactOpts, error)
+	GetCallOpts(ctx context.Context) *bind.CallOpts
+	Execute(ctx context.Context, build BuildTxFunc, opts ...ExecutionOption) (*TransactionResponse, error)
+	ExecuteAndWait(ctx context.Context, build BuildTxFunc, opts ...ExecutionOption) (*TransactionResponse, error)
+	SignMetaTx(ctx context.Context, req MetaTxRequest) (*SignedMetaTx, error)
+	RelayMetaTx(ctx context.Context, signed *SignedMetaTx) (common.Hash, error)
+	Close()
+
+	Payment() *PaymentService
+	Bridge() *BridgeService
+	Naming() *NamingService
+	Staking() *StakingService
+	Wallet() *WalletService
+	Token() *TokenService
+	Subscriptions() *SubscriptionsService
+}
+
+// client is the concrete implementation of Client.
+type client struct {
 	config     *Config
-	l2Client   *ethclient.Client
-	l1Client   *ethclient.Client
+	l2Client   ChainBackend
+	l1Client   ChainBackend
 	privateKey *ecdsa.PrivateKey
 	address    common.Address
 
 	// Services
-	Payment *PaymentService
-	Bridge  *BridgeService
-	Naming  *NamingService
-	Staking *StakingService
-	Wallet  *WalletService
+	payment *PaymentService
+	bridge  *BridgeService
+	naming  *NamingService
+	staking *StakingService
+	wallet  *WalletService
+	token   *TokenService
+	subs    *SubscriptionsService
+
+	noncesMu sync.Mutex
+	nonces   map[common.Address]*addressNonce
+
+	// closeCtx is cancelled by Close, bounding the lifetime of background
+	// work (e.g. token.warmCache) started outside of any caller's context.
+	closeCtx    context.Context
+	closeCancel context.CancelFunc
 }
 
+var _ Client = (*client)(nil)
+
 // Config holds the configuration for the Enter L2 client
 type Config struct {
 	// Network endpoints
@@ -40,6 +81,11 @@ type Config struct {
 	APIURL   string
 	WSURL    string
 
+	// RelayerURL is the endpoint of a gasless-transaction relayer that
+	// accepts signed meta-transactions (see Client.RelayMetaTx), pays the
+	// gas, and is reimbursed in the requested fee token.
+	RelayerURL string
+
 	// Network settings
 	ChainID *big.Int
 	Timeout time.Duration
@@ -58,6 +104,7 @@ type ContractAddresses struct {
 	StakingPool   common.Address
 	USDC          common.Address
 	USDT          common.Address
+	Forwarder     common.Address
 }
 
 // WalletType represents the type of wallet
@@ -89,8 +136,9 @@ const (
 	TransactionStatusCancelled TransactionStatus = 3
 )
 
-// NewClient creates a new Enter L2 client
-func NewClient(config *Config) (*Client, error) {
+// NewClient creates a new Enter L2 client, dialing the configured L2 (and,
+// if set, L1) JSON-RPC endpoints via ethclient.
+func NewClient(config *Config) (Client, error) {
 	// Connect to L2 network
 	l2Client, err := ethclient.Dial(config.L2RPCURL)
 	if err != nil {
@@ -106,29 +154,56 @@ func NewClient(config *Config) (*Client, error) {
 		}
 	}
 
+	var l1Backend ChainBackend
+	if l1Client != nil {
+		l1Backend = l1Client
+	}
+
+	return NewClientWithBackend(config, l2Client, l1Backend)
+}
+
+// NewClientWithBackend creates a new Enter L2 client backed by the given
+// ChainBackend implementations instead of dialing ethclient.Dial directly.
+// This allows tests and integrations to inject fakes or route through
+// custom RPC transports (batching proxies, IPC, etc.). l1 may be nil if no
+// L1 connectivity is required.
+func NewClientWithBackend(config *Config, l2 ChainBackend, l1 ChainBackend) (Client, error) {
+	if l2 == nil {
+		return nil, fmt.Errorf("l2 backend is required")
+	}
+
 	// Set default timeout
 	if config.Timeout == 0 {
 		config.Timeout = 30 * time.Second
 	}
 
-	client := &Client{
+	c := &client{
 		config:   config,
-		l2Client: l2Client,
-		l1Client: l1Client,
+		l2Client: l2,
+		l1Client: l1,
 	}
+	c.closeCtx, c.closeCancel = context.WithCancel(context.Background())
 
 	// Initialize services
-	client.Payment = NewPaymentService(client)
-	client.Bridge = NewBridgeService(client)
-	client.Naming = NewNamingService(client)
-	client.Staking = NewStakingService(client)
-	client.Wallet = NewWalletService(client)
-
-	return client, nil
+	c.payment = NewPaymentService(c)
+	c.bridge = NewBridgeService(c)
+	c.naming = NewNamingService(c)
+	c.staking = NewStakingService(c)
+	c.wallet = NewWalletService(c)
+	c.token = NewTokenService(c)
+	c.subs = NewSubscriptionsService(c)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(c.closeCtx, config.Timeout)
+		defer cancel()
+		c.token.warmCache(ctx)
+	}()
+
+	return c, nil
 }
 
 // Connect connects the client with a private key
-func (c *Client) Connect(privateKeyHex string) error {
+func (c *client) Connect(privateKeyHex string) error {
 	// Parse private key
 	privateKey, err := crypto.HexToECDSA(privateKeyHex)
 	if err != nil {
@@ -151,40 +226,41 @@ func (c *Client) Connect(privateKeyHex string) error {
 }
 
 // GetAddress returns the current address
-func (c *Client) GetAddress() common.Address {
+func (c *client) GetAddress() common.Address {
 	return c.address
 }
 
 // IsConnected returns true if the client is connected with a private key
-func (c *Client) IsConnected() bool {
+func (c *client) IsConnected() bool {
 	return c.privateKey != nil
 }
 
-// GetBalance returns the balance for a specific token
-func (c *Client) GetBalance(ctx context.Context, tokenAddress *common.Address) (*big.Int, error) {
+// GetBalance returns the balance for a specific token, or the native ETH
+// balance if tokenAddress is nil.
+func (c *client) GetBalance(ctx context.Context, tokenAddress *common.Address) (*big.Int, error) {
 	if tokenAddress == nil {
-		// Get ETH balance
 		return c.l2Client.BalanceAt(ctx, c.address, nil)
 	}
 
-	// Get ERC20 token balance
-	// This would use the ERC20 contract ABI to call balanceOf
-	// For brevity, returning a placeholder
-	return big.NewInt(0), fmt.Errorf("ERC20 balance not implemented in this example")
+	balance, err := c.token.BalanceOf(ctx, *tokenAddress, c.address)
+	if err != nil {
+		return nil, err
+	}
+	return balance.Balance, nil
 }
 
 // GetTransaction returns transaction details by hash
-func (c *Client) GetTransaction(ctx context.Context, hash common.Hash) (*TransactionResponse, error) {
+func (c *client) GetTransaction(ctx context.Context, hash common.Hash) (*TransactionResponse, error) {
 	tx, isPending, err := c.l2Client.TransactionByHash(ctx, hash)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get transaction: %w", err)
+		return nil, ParseRPCError(err)
 	}
 
 	var receipt *types.Receipt
 	if !isPending {
 		receipt, err = c.l2Client.TransactionReceipt(ctx, hash)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get receipt: %w", err)
+			return nil, ParseRPCError(err)
 		}
 	}
 
@@ -210,7 +286,7 @@ func (c *Client) GetTransaction(ctx context.Context, hash common.Hash) (*Transac
 }
 
 // WaitForTransaction waits for a transaction to be confirmed
-func (c *Client) WaitForTransaction(ctx context.Context, hash common.Hash, confirmations uint64) (*TransactionResponse, error) {
+func (c *client) WaitForTransaction(ctx context.Context, hash common.Hash, confirmations uint64) (*TransactionResponse, error) {
 	// Create a context with timeout
 	timeoutCtx, cancel := context.WithTimeout(ctx, c.config.Timeout)
 	defer cancel()
@@ -221,7 +297,7 @@ func (c *Client) WaitForTransaction(ctx context.Context, hash common.Hash, confi
 	for {
 		select {
 		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for transaction")
+			return nil, NewEnterL2Error("timeout waiting for transaction", ErrCodeUnknown, hash)
 		case <-ticker.C:
 			tx, err := c.GetTransaction(timeoutCtx, hash)
 			if err != nil {
@@ -243,22 +319,28 @@ func (c *Client) WaitForTransaction(ctx context.Context, hash common.Hash, confi
 					return tx, nil
 				}
 			} else if tx.Status == TransactionStatusFailed {
-				return tx, fmt.Errorf("transaction failed")
+				return tx, NewEnterL2Error("transaction failed", ErrCodeRevert, hash)
 			}
 		}
 	}
 }
 
 // SendTransaction sends a signed transaction
-func (c *Client) SendTransaction(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+func (c *client) SendTransaction(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
 	if c.privateKey == nil {
 		return common.Hash{}, fmt.Errorf("client not connected")
 	}
 
+	if to := tx.To(); to != nil {
+		if err := c.wallet.preflightCheck(ctx, c.address, *to, tx.Value()); err != nil {
+			return common.Hash{}, err
+		}
+	}
+
 	// Get chain ID
 	chainID, err := c.l2Client.NetworkID(ctx)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("failed to get chain ID: %w", err)
+		return common.Hash{}, ParseRPCError(err)
 	}
 
 	// Sign transaction
@@ -270,14 +352,17 @@ func (c *Client) SendTransaction(ctx context.Context, tx *types.Transaction) (co
 	// Send transaction
 	err = c.l2Client.SendTransaction(ctx, signedTx)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("failed to send transaction: %w", err)
+		return common.Hash{}, ParseRPCError(err)
+	}
+	if tx.To() != nil {
+		c.wallet.commitSpend(c.address, tx.Value())
 	}
 
 	return signedTx.Hash(), nil
 }
 
 // GetTransactOpts returns transaction options for contract calls
-func (c *Client) GetTransactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+func (c *client) GetTransactOpts(ctx context.Context) (*bind.TransactOpts, error) {
 	if c.privateKey == nil {
 		return nil, fmt.Errorf("client not connected")
 	}
@@ -297,15 +382,19 @@ func (c *Client) GetTransactOpts(ctx context.Context) (*bind.TransactOpts, error
 }
 
 // GetCallOpts returns call options for contract calls
-func (c *Client) GetCallOpts(ctx context.Context) *bind.CallOpts {
+func (c *client) GetCallOpts(ctx context.Context) *bind.CallOpts {
 	return &bind.CallOpts{
 		Context: ctx,
 		From:    c.address,
 	}
 }
 
-// Close closes the client connections
-func (c *Client) Close() {
+// Close closes the client connections and cancels any background work
+// (e.g. a still-running token.warmCache) started by NewClientWithBackend.
+func (c *client) Close() {
+	if c.closeCancel != nil {
+		c.closeCancel()
+	}
 	if c.l2Client != nil {
 		c.l2Client.Close()
 	}
@@ -314,11 +403,35 @@ func (c *Client) Close() {
 	}
 }
 
-// Helper function to extract from address from transaction
-func (c *Client) getFromAddress(tx *types.Transaction) common.Address {
-	// This would normally recover the from address from the transaction signature
-	// For brevity, returning zero address
-	return common.Address{}
+// Payment returns the client's payment service.
+func (c *client) Payment() *PaymentService { return c.payment }
+
+// Bridge returns the client's bridge service.
+func (c *client) Bridge() *BridgeService { return c.bridge }
+
+// Naming returns the client's naming service.
+func (c *client) Naming() *NamingService { return c.naming }
+
+// Staking returns the client's staking service.
+func (c *client) Staking() *StakingService { return c.staking }
+
+// Wallet returns the client's wallet service.
+func (c *client) Wallet() *WalletService { return c.wallet }
+
+// Token returns the client's ERC20 token service.
+func (c *client) Token() *TokenService { return c.token }
+
+// Subscriptions returns the client's event subscription service.
+func (c *client) Subscriptions() *SubscriptionsService { return c.subs }
+
+// getFromAddress recovers the sender of tx from its signature.
+func (c *client) getFromAddress(tx *types.Transaction) common.Address {
+	signer := types.LatestSignerForChainID(tx.ChainId())
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return common.Address{}
+	}
+	return from
 }
 
 // TransactionResponse represents a transaction response
@@ -348,6 +461,16 @@ type PaymentRequest struct {
 	Token       *common.Address
 	Description *string
 	GasLimit    *uint64
+
+	// Gasless routes the payment through the meta-transaction relayer
+	// (see Client.SignMetaTx/RelayMetaTx) instead of submitting it
+	// directly, so the sender pays no native gas.
+	Gasless bool
+	// FeeToken is the token the relayer is reimbursed in when Gasless is
+	// set. Defaults to Token if unset.
+	FeeToken *common.Address
+	// MaxFee bounds how much of FeeToken the relayer may charge.
+	MaxFee *big.Int
 }
 
 // WalletInfo represents wallet information
@@ -373,12 +496,21 @@ type EnterL2Error struct {
 	Message string
 	Code    string
 	Data    interface{}
+
+	// Cause is the underlying error ParseRPCError was given, if any.
+	Cause error
 }
 
 func (e *EnterL2Error) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error, allowing errors.Is/As to see
+// through an EnterL2Error to the original RPC error it wraps.
+func (e *EnterL2Error) Unwrap() error {
+	return e.Cause
+}
+
 // NewEnterL2Error creates a new Enter L2 error
 func NewEnterL2Error(message, code string, data interface{}) *EnterL2Error {
 	return &EnterL2Error{
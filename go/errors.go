@@ -0,0 +1,173 @@
+package enterl2
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Well-known EnterL2Error codes. ParseRPCError produces ErrCodeRevert,
+// ErrCodePanic and ErrCodeUnknown; RelayMetaTx reports relayer failures
+// with ErrCodeRPC.
+const (
+	ErrCodeRPC     = "RPC_ERROR"
+	ErrCodeRevert  = "EXECUTION_REVERTED"
+	ErrCodePanic   = "PANIC"
+	ErrCodeUnknown = "UNKNOWN"
+)
+
+// revertSelectorErrorString and revertSelectorPanic are the selectors of
+// Solidity's built-in Error(string) and Panic(uint256).
+var (
+	revertSelectorErrorString = [4]byte{0x08, 0xc3, 0x79, 0xa0}
+	revertSelectorPanic       = [4]byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// RevertDecoder decodes the ABI-encoded arguments (everything after the
+// 4-byte selector) of a custom revert error into a human-readable message
+// and the decoded arguments.
+type RevertDecoder func(args []byte) (message string, decoded interface{}, err error)
+
+var revertRegistry = map[[4]byte]RevertDecoder{
+	revertSelectorErrorString: decodeErrorString,
+	revertSelectorPanic:       decodePanic,
+}
+
+// RegisterRevertSelector registers a decoder for a custom revert error
+// selector (e.g. the Bridge's InsufficientLiquidity(uint256)), so
+// ParseRPCError can surface a decoded message and arguments for it instead
+// of leaving callers to pattern-match raw revert bytes.
+func RegisterRevertSelector(selector [4]byte, decoder RevertDecoder) {
+	revertRegistry[selector] = decoder
+}
+
+func decodeErrorString(args []byte) (string, interface{}, error) {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		return "", nil, err
+	}
+	values, err := abi.Arguments{{Type: stringType}}.Unpack(args)
+	if err != nil {
+		return "", nil, err
+	}
+	reason, _ := values[0].(string)
+	return reason, reason, nil
+}
+
+// panicReasons maps the Solidity compiler's built-in Panic(uint256) codes
+// to their documented meaning.
+var panicReasons = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array access",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to uninitialized function pointer",
+}
+
+func decodePanic(args []byte) (string, interface{}, error) {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return "", nil, err
+	}
+	values, err := abi.Arguments{{Type: uint256Type}}.Unpack(args)
+	if err != nil {
+		return "", nil, err
+	}
+	code := values[0].(*big.Int)
+
+	reason, ok := panicReasons[code.Uint64()]
+	if !ok {
+		reason = fmt.Sprintf("unknown panic code 0x%x", code)
+	}
+	return reason, code, nil
+}
+
+// EnterL2Error.Data carries the raw revert bytes plus, when the selector is
+// recognized, the decoded arguments.
+type RevertData struct {
+	Raw     []byte
+	Decoded interface{}
+}
+
+// ParseRPCError inspects err as returned by a ChainBackend call (e.g.
+// SendTransaction, CallContract, or a contract binding) and returns a typed
+// EnterL2Error. Code is the JSON-RPC error code as a decimal string when err
+// carries one, and ErrCodeUnknown otherwise. When the revert data starts
+// with a registered selector and decodes, Code becomes ErrCodePanic (for
+// Panic(uint256)) or ErrCodeRevert, Message the decoded reason, and Data a
+// *RevertData with the raw bytes and decoded arguments; otherwise Message
+// is err's text and Data the raw revert bytes (nil if there are none).
+func ParseRPCError(err error) *EnterL2Error {
+	if err == nil {
+		return nil
+	}
+	if e2e, ok := err.(*EnterL2Error); ok {
+		return e2e
+	}
+
+	code := ErrCodeUnknown
+	if rpcErr, ok := err.(rpc.Error); ok {
+		code = strconv.Itoa(rpcErr.ErrorCode())
+	}
+
+	var revert []byte
+	if dataErr, ok := err.(rpc.DataError); ok {
+		revert = extractRevertBytes(dataErr.ErrorData())
+	}
+
+	message := err.Error()
+	var data interface{} = revert
+
+	if len(revert) >= 4 {
+		var selector [4]byte
+		copy(selector[:], revert[:4])
+		if decoder, ok := revertRegistry[selector]; ok {
+			if decodedMessage, decodedArgs, decodeErr := decoder(revert[4:]); decodeErr == nil {
+				code = ErrCodeRevert
+				if selector == revertSelectorPanic {
+					code = ErrCodePanic
+				}
+				if decodedMessage != "" {
+					message = decodedMessage
+				}
+				data = &RevertData{Raw: revert, Decoded: decodedArgs}
+			}
+		}
+	}
+
+	return &EnterL2Error{
+		Message: message,
+		Code:    code,
+		Data:    data,
+		Cause:   err,
+	}
+}
+
+// extractRevertBytes normalizes an RPC error's data payload (a hex string,
+// with or without 0x, or []byte) into raw bytes. Any other shape, or a
+// string that is not valid hex, yields nil.
+func extractRevertBytes(data interface{}) []byte {
+	switch v := data.(type) {
+	case []byte:
+		return v
+	case string:
+		s := strings.TrimPrefix(v, "0x")
+		raw, err := hexutil.Decode("0x" + s)
+		if err != nil {
+			return nil
+		}
+		return raw
+	default:
+		return nil
+	}
+}
+
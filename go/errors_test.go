@@ -0,0 +1,129 @@
+package enterl2
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func TestDecodeErrorString(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatalf("abi.NewType: %v", err)
+	}
+	args, err := abi.Arguments{{Type: stringType}}.Pack("insufficient balance")
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+
+	message, decoded, err := decodeErrorString(args)
+	if err != nil {
+		t.Fatalf("decodeErrorString: %v", err)
+	}
+	if message != "insufficient balance" {
+		t.Fatalf("message = %q, want %q", message, "insufficient balance")
+	}
+	if decoded != "insufficient balance" {
+		t.Fatalf("decoded = %v, want %q", decoded, "insufficient balance")
+	}
+}
+
+func TestDecodePanic(t *testing.T) {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		t.Fatalf("abi.NewType: %v", err)
+	}
+
+	for code, want := range panicReasons {
+		args, err := abi.Arguments{{Type: uint256Type}}.Pack(new(big.Int).SetUint64(code))
+		if err != nil {
+			t.Fatalf("Pack(0x%x): %v", code, err)
+		}
+		message, _, err := decodePanic(args)
+		if err != nil {
+			t.Fatalf("decodePanic(0x%x): %v", code, err)
+		}
+		if message != want {
+			t.Fatalf("decodePanic(0x%x) = %q, want %q", code, message, want)
+		}
+	}
+}
+
+func TestDecodePanicUnknownCode(t *testing.T) {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		t.Fatalf("abi.NewType: %v", err)
+	}
+	args, err := abi.Arguments{{Type: uint256Type}}.Pack(big.NewInt(0x99))
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+
+	message, decoded, err := decodePanic(args)
+	if err != nil {
+		t.Fatalf("decodePanic: %v", err)
+	}
+	if message != "unknown panic code 0x99" {
+		t.Fatalf("message = %q, want the unknown-code fallback", message)
+	}
+	if decoded.(*big.Int).Uint64() != 0x99 {
+		t.Fatalf("decoded = %v, want 0x99", decoded)
+	}
+}
+
+// rpcTestError implements rpc.Error and rpc.DataError, mirroring the shape
+// an ethclient call returns for a reverted transaction.
+type rpcTestError struct {
+	code int
+	data interface{}
+}
+
+func (e *rpcTestError) Error() string          { return fmt.Sprintf("rpc error %d", e.code) }
+func (e *rpcTestError) ErrorCode() int         { return e.code }
+func (e *rpcTestError) ErrorData() interface{} { return e.data }
+
+func TestParseRPCErrorDecodesKnownRevertSelector(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatalf("abi.NewType: %v", err)
+	}
+	packed, err := abi.Arguments{{Type: stringType}}.Pack("nope")
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	revert := append(append([]byte{}, revertSelectorErrorString[:]...), packed...)
+
+	err = &rpcTestError{code: 3, data: "0x" + fmt.Sprintf("%x", revert)}
+	parsed := ParseRPCError(err)
+
+	if parsed.Code != ErrCodeRevert {
+		t.Fatalf("Code = %q, want %q", parsed.Code, ErrCodeRevert)
+	}
+	if parsed.Message != "nope" {
+		t.Fatalf("Message = %q, want %q", parsed.Message, "nope")
+	}
+	if parsed.Cause != err {
+		t.Fatalf("Cause not preserved")
+	}
+}
+
+func TestParseRPCErrorUnknownFallsBackToCode(t *testing.T) {
+	err := &rpcTestError{code: 7}
+	parsed := ParseRPCError(err)
+
+	if parsed.Code != "7" {
+		t.Fatalf("Code = %q, want %q", parsed.Code, "7")
+	}
+	if parsed.Message != err.Error() {
+		t.Fatalf("Message = %q, want %q", parsed.Message, err.Error())
+	}
+}
+
+func TestParseRPCErrorPassesThroughEnterL2Error(t *testing.T) {
+	original := NewEnterL2Error("already wrapped", ErrCodeRPC, nil)
+	if ParseRPCError(original) != original {
+		t.Fatalf("ParseRPCError should return an existing *EnterL2Error unchanged")
+	}
+}
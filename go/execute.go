@@ -0,0 +1,272 @@
+package enterl2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// BuildTxFunc builds and signs a transaction using the supplied
+// TransactOpts. Implementations typically call into a generated contract
+// binding (e.g. contract.Transfer(opts, ...)); the returned transaction
+// must already be signed (opts.Signer does this). Execute sets opts.NoSend
+// so a binding returns the signed transaction without broadcasting it, and
+// then broadcasts it itself.
+type BuildTxFunc func(ctx context.Context, opts *bind.TransactOpts) (*types.Transaction, error)
+
+// defaultPendingTransactionCheckPeriod is how often Execute polls a pending
+// transaction to decide whether it should be replaced when a replacement
+// policy is in effect.
+const defaultPendingTransactionCheckPeriod = 15 * time.Second
+
+// executionOptions holds the resolved settings for a single Execute call.
+type executionOptions struct {
+	gasTipCap          *big.Int
+	gasFeeCap          *big.Int
+	nonce              *uint64
+	deadline           time.Duration
+	replace            bool
+	replaceBumpPercent int64
+	pendingCheckPeriod time.Duration
+}
+
+// ExecutionOption configures a call to Execute/ExecuteAndWait.
+type ExecutionOption func(*executionOptions)
+
+// WithGasTipCap sets an explicit EIP-1559 gas tip cap, overriding the
+// estimate GetTransactOpts would otherwise leave to the backend.
+func WithGasTipCap(tip *big.Int) ExecutionOption {
+	return func(o *executionOptions) { o.gasTipCap = tip }
+}
+
+// WithGasFeeCap sets an explicit EIP-1559 gas fee cap.
+func WithGasFeeCap(fee *big.Int) ExecutionOption {
+	return func(o *executionOptions) { o.gasFeeCap = fee }
+}
+
+// WithNonce overrides the nonce Execute would otherwise assign from its
+// local nonce tracker.
+func WithNonce(nonce uint64) ExecutionOption {
+	return func(o *executionOptions) { o.nonce = &nonce }
+}
+
+// WithDeadline bounds how long Execute waits on a pending transaction
+// before giving up (ExecuteAndWait only).
+func WithDeadline(d time.Duration) ExecutionOption {
+	return func(o *executionOptions) { o.deadline = d }
+}
+
+// WithReplacement enables automatic replacement: if the transaction is
+// still pending after checkPeriod, Execute resubmits it at the same nonce
+// with its gas tip/fee cap bumped by bumpPercent (e.g. 10 for +10%). A
+// non-positive checkPeriod keeps defaultPendingTransactionCheckPeriod.
+func WithReplacement(checkPeriod time.Duration, bumpPercent int64) ExecutionOption {
+	return func(o *executionOptions) {
+		o.replace = true
+		if checkPeriod > 0 {
+			o.pendingCheckPeriod = checkPeriod
+		}
+		o.replaceBumpPercent = bumpPercent
+	}
+}
+
+// addressNonce serializes and tracks the pending nonce for a single
+// sending address, so concurrent Execute calls from the SDK never collide
+// on the same nonce.
+type addressNonce struct {
+	mu      sync.Mutex
+	pending *uint64
+}
+
+// Execute builds, signs, and submits a transaction via build, serializing
+// concurrent sends from the same address and tracking the pending nonce
+// locally so back-to-back calls don't need to round-trip PendingNonceAt.
+func (c *client) Execute(ctx context.Context, build BuildTxFunc, opts ...ExecutionOption) (*TransactionResponse, error) {
+	if c.privateKey == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	options := &executionOptions{pendingCheckPeriod: defaultPendingTransactionCheckPeriod}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	an := c.addressNonce(c.address)
+	an.mu.Lock()
+	defer an.mu.Unlock()
+
+	nonce, err := c.resolveNonce(ctx, an, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve nonce: %w", err)
+	}
+
+	auth, err := c.GetTransactOpts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	auth.Nonce = new(big.Int).SetUint64(nonce)
+	auth.NoSend = true
+	if options.gasTipCap != nil {
+		auth.GasTipCap = options.gasTipCap
+	}
+	if options.gasFeeCap != nil {
+		auth.GasFeeCap = options.gasFeeCap
+	}
+
+	tx, err := build(ctx, auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: %w", err)
+	}
+
+	if err := c.l2Client.SendTransaction(ctx, tx); err != nil {
+		an.refresh(ctx, c)
+		return nil, ParseRPCError(err)
+	}
+
+	next := nonce + 1
+	an.pending = &next
+
+	if options.replace {
+		go c.watchForReplacement(ctx, tx, auth, nonce, options)
+	}
+
+	return &TransactionResponse{
+		Hash:   tx.Hash(),
+		From:   c.address,
+		To:     tx.To(),
+		Amount: tx.Value(),
+		Status: TransactionStatusPending,
+	}, nil
+}
+
+// ExecuteAndWait behaves like Execute but additionally waits for the
+// transaction to be confirmed (or for options.deadline to elapse, if set)
+// before returning.
+func (c *client) ExecuteAndWait(ctx context.Context, build BuildTxFunc, opts ...ExecutionOption) (*TransactionResponse, error) {
+	resp, err := c.Execute(ctx, build, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	waitCtx := ctx
+	options := &executionOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.deadline > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, options.deadline)
+		defer cancel()
+	}
+
+	return c.WaitForTransaction(waitCtx, resp.Hash, 0)
+}
+
+// resolveNonce returns the nonce to use for the next transaction from
+// address, preferring an explicit override, then the locally tracked
+// pending nonce, and finally PendingNonceAt as a last resort.
+func (c *client) resolveNonce(ctx context.Context, an *addressNonce, options *executionOptions) (uint64, error) {
+	if options.nonce != nil {
+		return *options.nonce, nil
+	}
+	if an.pending != nil {
+		return *an.pending, nil
+	}
+	return an.refresh(ctx, c)
+}
+
+// refresh re-fetches the pending nonce from the backend and updates the
+// local cache, used when no nonce is tracked yet for the address and after
+// a failed send.
+func (an *addressNonce) refresh(ctx context.Context, c *client) (uint64, error) {
+	nonce, err := c.l2Client.PendingNonceAt(ctx, c.address)
+	if err != nil {
+		return 0, err
+	}
+	an.pending = &nonce
+	return nonce, nil
+}
+
+// addressNonce returns (creating if necessary) the nonce tracker for
+// address, used to serialize sends per-sender.
+func (c *client) addressNonce(address common.Address) *addressNonce {
+	c.noncesMu.Lock()
+	defer c.noncesMu.Unlock()
+
+	if c.nonces == nil {
+		c.nonces = make(map[common.Address]*addressNonce)
+	}
+	an, ok := c.nonces[address]
+	if !ok {
+		an = &addressNonce{}
+		c.nonces[address] = an
+	}
+	return an
+}
+
+// watchForReplacement polls tx and, if it is still pending after
+// options.pendingCheckPeriod, resubmits it at the same nonce with a bumped
+// gas tip/fee cap. It stops as soon as ctx (the context passed into the
+// originating Execute/ExecuteAndWait call) is cancelled, so callers can
+// actually bound its lifetime instead of it running until the tx lands.
+func (c *client) watchForReplacement(ctx context.Context, tx *types.Transaction, auth *bind.TransactOpts, nonce uint64, options *executionOptions) {
+	ticker := time.NewTicker(options.pendingCheckPeriod)
+	defer ticker.Stop()
+
+	current := tx
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, isPending, err := c.l2Client.TransactionByHash(ctx, current.Hash())
+		if err != nil || !isPending {
+			return
+		}
+
+		bumped := bumpGasPrice(current, options.replaceBumpPercent)
+		signer := types.LatestSignerForChainID(current.ChainId())
+		replacement, err := types.SignTx(bumped, signer, c.privateKey)
+		if err != nil {
+			return
+		}
+		if err := c.l2Client.SendTransaction(ctx, replacement); err != nil {
+			return
+		}
+		current = replacement
+	}
+}
+
+// bumpGasPrice returns a dynamic-fee copy of tx with its gas tip/fee cap
+// increased by percent; for a legacy tx, its gas price is bumped and used
+// as both caps.
+func bumpGasPrice(tx *types.Transaction, percent int64) *types.Transaction {
+	bump := func(v *big.Int) *big.Int {
+		if v == nil {
+			return nil
+		}
+		delta := new(big.Int).Mul(v, big.NewInt(percent))
+		delta.Div(delta, big.NewInt(100))
+		return new(big.Int).Add(v, delta)
+	}
+
+	inner := &types.DynamicFeeTx{
+		ChainID:   tx.ChainId(),
+		Nonce:     tx.Nonce(),
+		GasTipCap: bump(tx.GasTipCap()),
+		GasFeeCap: bump(tx.GasFeeCap()),
+		Gas:       tx.Gas(),
+		To:        tx.To(),
+		Value:     tx.Value(),
+		Data:      tx.Data(),
+	}
+	return types.NewTx(inner)
+}
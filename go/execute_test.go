@@ -0,0 +1,42 @@
+package enterl2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestBumpGasPrice(t *testing.T) {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     5,
+		GasTipCap: big.NewInt(100),
+		GasFeeCap: big.NewInt(1000),
+		Gas:       21000,
+	})
+
+	bumped := bumpGasPrice(tx, 10)
+	if got, want := bumped.GasTipCap(), big.NewInt(110); got.Cmp(want) != 0 {
+		t.Fatalf("GasTipCap = %v, want %v", got, want)
+	}
+	if got, want := bumped.GasFeeCap(), big.NewInt(1100); got.Cmp(want) != 0 {
+		t.Fatalf("GasFeeCap = %v, want %v", got, want)
+	}
+	if bumped.Nonce() != tx.Nonce() {
+		t.Fatalf("Nonce = %d, want %d (replacement must reuse the same nonce)", bumped.Nonce(), tx.Nonce())
+	}
+}
+
+func TestBumpGasPriceLegacyTx(t *testing.T) {
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(100), nil)
+
+	bumped := bumpGasPrice(tx, 10)
+	if got, want := bumped.GasTipCap(), big.NewInt(110); got.Cmp(want) != 0 {
+		t.Fatalf("GasTipCap = %v, want %v (legacy gas price bumped by 10%%)", got, want)
+	}
+	if got, want := bumped.GasFeeCap(), big.NewInt(110); got.Cmp(want) != 0 {
+		t.Fatalf("GasFeeCap = %v, want %v (legacy gas price bumped by 10%%)", got, want)
+	}
+}
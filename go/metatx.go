@@ -0,0 +1,219 @@
+package enterl2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	cmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// forwarderABI is the minimal ABI of the EnterL2 forwarder contract used to
+// read the sender's replay-protection nonce for meta-transactions.
+const forwarderABI = `[
+	{"constant":true,"inputs":[{"name":"from","type":"address"}],"name":"nonces","outputs":[{"name":"","type":"uint256"}],"type":"function"}
+]`
+
+// MetaTxRequest describes a gasless transaction to be signed by the
+// connected account and relayed on its behalf.
+type MetaTxRequest struct {
+	From     common.Address
+	To       common.Address
+	Value    *big.Int
+	Data     []byte
+	Deadline time.Time
+	FeeToken common.Address
+	MaxFee   *big.Int
+}
+
+// SignedMetaTx is a MetaTxRequest together with its replay-protection
+// nonce and the EIP-712 signature authorizing it.
+type SignedMetaTx struct {
+	Request   MetaTxRequest
+	Nonce     *big.Int
+	Signature []byte
+}
+
+// SignMetaTx produces an EIP-712 typed-data signature over req using the
+// connected account's private key, authorizing a relayer to submit it on
+// the sender's behalf in exchange for req.FeeToken up to req.MaxFee. The
+// domain's VerifyingContract is the configured Forwarder contract, which
+// must verify the signature against this same domain on-chain.
+func (c *client) SignMetaTx(ctx context.Context, req MetaTxRequest) (*SignedMetaTx, error) {
+	if c.privateKey == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	nonce, err := c.forwarderNonce(ctx, req.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read forwarder nonce: %w", err)
+	}
+
+	chainID, err := c.l2Client.NetworkID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	value := req.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	maxFee := req.MaxFee
+	if maxFee == nil {
+		maxFee = big.NewInt(0)
+	}
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"ForwardRequest": {
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+				{Name: "feeToken", Type: "address"},
+				{Name: "maxFee", Type: "uint256"},
+			},
+		},
+		PrimaryType: "ForwardRequest",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "EnterL2Forwarder",
+			Version:           "1",
+			ChainId:           (*cmath.HexOrDecimal256)(chainID),
+			VerifyingContract: c.config.Contracts.Forwarder.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":     req.From.Hex(),
+			"to":       req.To.Hex(),
+			"value":    value.String(),
+			"data":     hexData(req.Data),
+			"nonce":    nonce.String(),
+			"deadline": big.NewInt(req.Deadline.Unix()).String(),
+			"feeToken": req.FeeToken.Hex(),
+			"maxFee":   maxFee.String(),
+		},
+	}
+
+	digest, err := typedDataHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash meta-tx typed data: %w", err)
+	}
+
+	sig, err := crypto.Sign(digest, c.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign meta-tx: %w", err)
+	}
+
+	return &SignedMetaTx{Request: req, Nonce: nonce, Signature: sig}, nil
+}
+
+// relayMetaTxPayload is the JSON body posted to Config.RelayerURL.
+type relayMetaTxPayload struct {
+	From      common.Address `json:"from"`
+	To        common.Address `json:"to"`
+	Value     string         `json:"value"`
+	Data      string         `json:"data"`
+	Nonce     string         `json:"nonce"`
+	Deadline  int64          `json:"deadline"`
+	FeeToken  common.Address `json:"feeToken"`
+	MaxFee    string         `json:"maxFee"`
+	Signature string         `json:"signature"`
+}
+
+type relayMetaTxResponse struct {
+	TxHash string `json:"txHash"`
+	Error  string `json:"error"`
+}
+
+// RelayMetaTx posts signed to the configured RelayerURL, which pays the
+// gas for it and is reimbursed in signed.Request.FeeToken, and returns the
+// resulting transaction hash.
+func (c *client) RelayMetaTx(ctx context.Context, signed *SignedMetaTx) (common.Hash, error) {
+	if c.config.RelayerURL == "" {
+		return common.Hash{}, fmt.Errorf("relayer URL is not configured")
+	}
+
+	req := signed.Request
+	value := req.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	maxFee := req.MaxFee
+	if maxFee == nil {
+		maxFee = big.NewInt(0)
+	}
+
+	body, err := json.Marshal(relayMetaTxPayload{
+		From:      req.From,
+		To:        req.To,
+		Value:     value.String(),
+		Data:      hexData(req.Data),
+		Nonce:     signed.Nonce.String(),
+		Deadline:  req.Deadline.Unix(),
+		FeeToken:  req.FeeToken,
+		MaxFee:    maxFee.String(),
+		Signature: hexData(signed.Signature),
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode meta-tx: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.RelayerURL, bytes.NewReader(body))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to build relayer request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to reach relayer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var relayResp relayMetaTxResponse
+	if err := json.NewDecoder(resp.Body).Decode(&relayResp); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to decode relayer response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || relayResp.Error != "" {
+		return common.Hash{}, NewEnterL2Error(relayResp.Error, ErrCodeRPC, resp.StatusCode)
+	}
+
+	return common.HexToHash(relayResp.TxHash), nil
+}
+
+// forwarderNonce reads the replay-protection nonce the Forwarder contract
+// has on record for from.
+func (c *client) forwarderNonce(ctx context.Context, from common.Address) (*big.Int, error) {
+	parsed, err := abi.JSON(strings.NewReader(forwarderABI))
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(c.config.Contracts.Forwarder, parsed, c.l2Client, c.l2Client, c.l2Client)
+
+	var out []interface{}
+	if err := contract.Call(c.GetCallOpts(ctx), &out, "nonces", from); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+func hexData(data []byte) string {
+	return "0x" + common.Bytes2Hex(data)
+}
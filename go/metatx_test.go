@@ -0,0 +1,175 @@
+package enterl2
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// TestTypedDataHashForwardRequestFixedVector pins typedDataHash's output
+// for a fixed ForwardRequest/domain against an independently computed
+// digest, so an accidental change to field order, type, or the EIP-712
+// encoding (e.g. hashing "data" as a raw value instead of keccak256(data))
+// changes this test instead of silently producing a signature the
+// on-chain forwarder rejects.
+func TestTypedDataHashForwardRequestFixedVector(t *testing.T) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"ForwardRequest": {
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+				{Name: "feeToken", Type: "address"},
+				{Name: "maxFee", Type: "uint256"},
+			},
+		},
+		PrimaryType: "ForwardRequest",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "EnterL2Forwarder",
+			Version:           "1",
+			ChainId:           (*cmath.HexOrDecimal256)(big.NewInt(1337)),
+			VerifyingContract: "0x4444444444444444444444444444444444444444",
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":     "0x1111111111111111111111111111111111111111",
+			"to":       "0x2222222222222222222222222222222222222222",
+			"value":    "1000000000000000000",
+			"data":     hexData([]byte{0xab, 0xcd}),
+			"nonce":    "7",
+			"deadline": "1999999999",
+			"feeToken": "0x3333333333333333333333333333333333333333",
+			"maxFee":   "500000",
+		},
+	}
+
+	digest, err := typedDataHash(typedData)
+	if err != nil {
+		t.Fatalf("typedDataHash: %v", err)
+	}
+
+	const want = "9213315ff9dd8bde451403f35a657a687458ba2318ac0a815a1f6bc426393266"
+	if got := common.Bytes2Hex(digest); got != want {
+		t.Fatalf("typedDataHash = %s, want %s", got, want)
+	}
+}
+
+func TestSignMetaTx(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	forwarderParsed, err := abi.JSON(strings.NewReader(forwarderABI))
+	if err != nil {
+		t.Fatalf("abi.JSON: %v", err)
+	}
+	packedNonce, err := forwarderParsed.Methods["nonces"].Outputs.Pack(big.NewInt(7))
+	if err != nil {
+		t.Fatalf("Pack nonce: %v", err)
+	}
+
+	backend := &stubBackend{
+		callContractFunc: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+			return packedNonce, nil
+		},
+		networkIDFunc: func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(1337), nil
+		},
+	}
+
+	c := &client{
+		config: &Config{
+			Contracts: ContractAddresses{Forwarder: common.HexToAddress("0x4444444444444444444444444444444444444444")},
+		},
+		l2Client:   backend,
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+
+	req := MetaTxRequest{
+		From:     c.address,
+		To:       common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		Value:    big.NewInt(0),
+		Deadline: time.Unix(1999999999, 0),
+		FeeToken: common.HexToAddress("0x3333333333333333333333333333333333333333"),
+		MaxFee:   big.NewInt(500000),
+	}
+
+	signed, err := c.SignMetaTx(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SignMetaTx: %v", err)
+	}
+	if signed.Nonce.Cmp(big.NewInt(7)) != 0 {
+		t.Fatalf("Nonce = %v, want 7 (from the stubbed forwarder call)", signed.Nonce)
+	}
+	if len(signed.Signature) != 65 {
+		t.Fatalf("Signature length = %d, want 65", len(signed.Signature))
+	}
+
+	digest, err := typedDataHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"ForwardRequest": {
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+				{Name: "feeToken", Type: "address"},
+				{Name: "maxFee", Type: "uint256"},
+			},
+		},
+		PrimaryType: "ForwardRequest",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "EnterL2Forwarder",
+			Version:           "1",
+			ChainId:           (*cmath.HexOrDecimal256)(big.NewInt(1337)),
+			VerifyingContract: c.config.Contracts.Forwarder.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":     req.From.Hex(),
+			"to":       req.To.Hex(),
+			"value":    "0",
+			"data":     hexData(nil),
+			"nonce":    "7",
+			"deadline": "1999999999",
+			"feeToken": req.FeeToken.Hex(),
+			"maxFee":   "500000",
+		},
+	})
+	if err != nil {
+		t.Fatalf("typedDataHash: %v", err)
+	}
+
+	recovered, err := crypto.SigToPub(digest, signed.Signature)
+	if err != nil {
+		t.Fatalf("SigToPub: %v", err)
+	}
+	if got := crypto.PubkeyToAddress(*recovered); got != c.address {
+		t.Fatalf("recovered signer = %s, want %s (signature does not cover the expected digest)", got.Hex(), c.address.Hex())
+	}
+}
@@ -0,0 +1,158 @@
+// Package mockclient provides a fake implementation of enterl2.Client for
+// unit testing downstream SDK consumers without a live network connection.
+package mockclient
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	enterl2 "github.com/Enter-L2/sdk/go"
+)
+
+// Client is a mock implementation of enterl2.Client. Every method delegates
+// to a matching func field so tests can stub out exactly the behavior they
+// need. With a field unset, the method returns a nil error and a zero
+// result: nil pointers, a zero hash or address, false, a zero balance
+// from GetBalance, and CallOpts carrying only ctx from GetCallOpts.
+type Client struct {
+	ConnectFunc            func(privateKeyHex string) error
+	GetAddressFunc         func() common.Address
+	IsConnectedFunc        func() bool
+	GetBalanceFunc         func(ctx context.Context, tokenAddress *common.Address) (*big.Int, error)
+	GetTransactionFunc     func(ctx context.Context, hash common.Hash) (*enterl2.TransactionResponse, error)
+	WaitForTransactionFunc func(ctx context.Context, hash common.Hash, confirmations uint64) (*enterl2.TransactionResponse, error)
+	SendTransactionFunc    func(ctx context.Context, tx *types.Transaction) (common.Hash, error)
+	GetTransactOptsFunc    func(ctx context.Context) (*bind.TransactOpts, error)
+	GetCallOptsFunc        func(ctx context.Context) *bind.CallOpts
+	ExecuteFunc            func(ctx context.Context, build enterl2.BuildTxFunc, opts ...enterl2.ExecutionOption) (*enterl2.TransactionResponse, error)
+	ExecuteAndWaitFunc     func(ctx context.Context, build enterl2.BuildTxFunc, opts ...enterl2.ExecutionOption) (*enterl2.TransactionResponse, error)
+	SignMetaTxFunc         func(ctx context.Context, req enterl2.MetaTxRequest) (*enterl2.SignedMetaTx, error)
+	RelayMetaTxFunc        func(ctx context.Context, signed *enterl2.SignedMetaTx) (common.Hash, error)
+	CloseFunc              func()
+
+	PaymentService       *enterl2.PaymentService
+	BridgeService        *enterl2.BridgeService
+	NamingService        *enterl2.NamingService
+	StakingService       *enterl2.StakingService
+	WalletService        *enterl2.WalletService
+	TokenService         *enterl2.TokenService
+	SubscriptionsService *enterl2.SubscriptionsService
+}
+
+var _ enterl2.Client = (*Client)(nil)
+
+// New returns a mock Client with no Func fields set, so every method
+// returns the zero result described on Client. Tests should set the Func
+// fields they care about.
+func New() *Client {
+	return &Client{}
+}
+
+func (c *Client) Connect(privateKeyHex string) error {
+	if c.ConnectFunc != nil {
+		return c.ConnectFunc(privateKeyHex)
+	}
+	return nil
+}
+
+func (c *Client) GetAddress() common.Address {
+	if c.GetAddressFunc != nil {
+		return c.GetAddressFunc()
+	}
+	return common.Address{}
+}
+
+func (c *Client) IsConnected() bool {
+	if c.IsConnectedFunc != nil {
+		return c.IsConnectedFunc()
+	}
+	return false
+}
+
+func (c *Client) GetBalance(ctx context.Context, tokenAddress *common.Address) (*big.Int, error) {
+	if c.GetBalanceFunc != nil {
+		return c.GetBalanceFunc(ctx, tokenAddress)
+	}
+	return big.NewInt(0), nil
+}
+
+func (c *Client) GetTransaction(ctx context.Context, hash common.Hash) (*enterl2.TransactionResponse, error) {
+	if c.GetTransactionFunc != nil {
+		return c.GetTransactionFunc(ctx, hash)
+	}
+	return nil, nil
+}
+
+func (c *Client) WaitForTransaction(ctx context.Context, hash common.Hash, confirmations uint64) (*enterl2.TransactionResponse, error) {
+	if c.WaitForTransactionFunc != nil {
+		return c.WaitForTransactionFunc(ctx, hash, confirmations)
+	}
+	return nil, nil
+}
+
+func (c *Client) SendTransaction(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	if c.SendTransactionFunc != nil {
+		return c.SendTransactionFunc(ctx, tx)
+	}
+	return common.Hash{}, nil
+}
+
+func (c *Client) GetTransactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	if c.GetTransactOptsFunc != nil {
+		return c.GetTransactOptsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (c *Client) GetCallOpts(ctx context.Context) *bind.CallOpts {
+	if c.GetCallOptsFunc != nil {
+		return c.GetCallOptsFunc(ctx)
+	}
+	return &bind.CallOpts{Context: ctx}
+}
+
+func (c *Client) Execute(ctx context.Context, build enterl2.BuildTxFunc, opts ...enterl2.ExecutionOption) (*enterl2.TransactionResponse, error) {
+	if c.ExecuteFunc != nil {
+		return c.ExecuteFunc(ctx, build, opts...)
+	}
+	return nil, nil
+}
+
+func (c *Client) ExecuteAndWait(ctx context.Context, build enterl2.BuildTxFunc, opts ...enterl2.ExecutionOption) (*enterl2.TransactionResponse, error) {
+	if c.ExecuteAndWaitFunc != nil {
+		return c.ExecuteAndWaitFunc(ctx, build, opts...)
+	}
+	return nil, nil
+}
+
+func (c *Client) SignMetaTx(ctx context.Context, req enterl2.MetaTxRequest) (*enterl2.SignedMetaTx, error) {
+	if c.SignMetaTxFunc != nil {
+		return c.SignMetaTxFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+func (c *Client) RelayMetaTx(ctx context.Context, signed *enterl2.SignedMetaTx) (common.Hash, error) {
+	if c.RelayMetaTxFunc != nil {
+		return c.RelayMetaTxFunc(ctx, signed)
+	}
+	return common.Hash{}, nil
+}
+
+func (c *Client) Close() {
+	if c.CloseFunc != nil {
+		c.CloseFunc()
+	}
+}
+
+func (c *Client) Payment() *enterl2.PaymentService             { return c.PaymentService }
+func (c *Client) Bridge() *enterl2.BridgeService               { return c.BridgeService }
+func (c *Client) Naming() *enterl2.NamingService               { return c.NamingService }
+func (c *Client) Staking() *enterl2.StakingService             { return c.StakingService }
+func (c *Client) Wallet() *enterl2.WalletService               { return c.WalletService }
+func (c *Client) Token() *enterl2.TokenService                 { return c.TokenService }
+func (c *Client) Subscriptions() *enterl2.SubscriptionsService { return c.SubscriptionsService }
@@ -0,0 +1,11 @@
+package enterl2
+
+// NamingService provides access to the Enter L2 name registry.
+type NamingService struct {
+	client *client
+}
+
+// NewNamingService creates a new naming service bound to client.
+func NewNamingService(c *client) *NamingService {
+	return &NamingService{client: c}
+}
@@ -0,0 +1,177 @@
+package enterl2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// metaTxDeadline is how far in the future a gasless payment's meta-tx
+// signature is valid for, giving the relayer enough time to submit it.
+const metaTxDeadline = 10 * time.Minute
+
+// PaymentService provides access to payment operations on the Enter L2
+// network.
+type PaymentService struct {
+	client *client
+}
+
+// NewPaymentService creates a new payment service bound to client.
+func NewPaymentService(c *client) *PaymentService {
+	return &PaymentService{client: c}
+}
+
+// Pay sends req.Amount of req.Token (or native ETH, if nil) to req.To. If
+// req.Gasless is set, it is signed as a meta-transaction and submitted
+// through the configured relayer instead of broadcast directly, so the
+// connected account pays no native gas.
+//
+// A direct (non-gasless) ERC20 payment delegates entirely to
+// TokenService.Transfer, which enforces the connected wallet's
+// whitelist/daily-limit itself; every other path enforces it here and only counts the spend
+// against the daily limit once the transfer has actually been submitted,
+// so a failed send never permanently eats into the wallet's budget.
+func (p *PaymentService) Pay(ctx context.Context, req PaymentRequest) (*TransactionResponse, error) {
+	if req.Token != nil && !req.Gasless {
+		return p.client.token.Transfer(ctx, *req.Token, req.To, req.Amount)
+	}
+
+	if err := p.client.wallet.preflightCheck(ctx, p.client.address, req.To, req.Amount); err != nil {
+		return nil, err
+	}
+
+	var (
+		resp *TransactionResponse
+		err  error
+	)
+	if req.Gasless {
+		resp, err = p.payGasless(ctx, req)
+	} else {
+		resp, err = p.payDirect(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.client.wallet.commitSpend(p.client.address, req.Amount)
+	return resp, nil
+}
+
+// payDirect broadcasts a native ETH transfer. ERC20 payments are routed
+// to TokenService.Transfer by Pay before reaching here.
+func (p *PaymentService) payDirect(ctx context.Context, req PaymentRequest) (*TransactionResponse, error) {
+	return p.client.Execute(ctx, func(ctx context.Context, opts *bind.TransactOpts) (*types.Transaction, error) {
+		gasLimit := uint64(21000)
+		if req.GasLimit != nil {
+			gasLimit = *req.GasLimit
+		}
+
+		// Honor WithGasTipCap/WithGasFeeCap the same way contract.Transact
+		// does, instead of only ever building a legacy transaction.
+		if opts.GasFeeCap != nil || opts.GasTipCap != nil {
+			chainID, err := p.client.l2Client.NetworkID(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get chain ID: %w", err)
+			}
+
+			gasTipCap := opts.GasTipCap
+			if gasTipCap == nil {
+				gasTipCap, err = p.client.l2Client.SuggestGasTipCap(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to suggest gas tip cap: %w", err)
+				}
+			}
+			gasFeeCap := opts.GasFeeCap
+			if gasFeeCap == nil {
+				gasFeeCap = gasTipCap
+			}
+
+			tx := types.NewTx(&types.DynamicFeeTx{
+				ChainID:   chainID,
+				Nonce:     opts.Nonce.Uint64(),
+				GasTipCap: gasTipCap,
+				GasFeeCap: gasFeeCap,
+				Gas:       gasLimit,
+				To:        &req.To,
+				Value:     req.Amount,
+			})
+			return opts.Signer(opts.From, tx)
+		}
+
+		gasPrice := opts.GasPrice
+		if gasPrice == nil {
+			var err error
+			gasPrice, err = p.client.l2Client.SuggestGasPrice(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to suggest gas price: %w", err)
+			}
+		}
+
+		tx := types.NewTransaction(opts.Nonce.Uint64(), req.To, req.Amount, gasLimit, gasPrice, nil)
+		return opts.Signer(opts.From, tx)
+	})
+}
+
+// payGasless signs req as a meta-transaction and relays it, so the
+// connected account never broadcasts a transaction itself.
+func (p *PaymentService) payGasless(ctx context.Context, req PaymentRequest) (*TransactionResponse, error) {
+	feeToken := req.FeeToken
+	if feeToken == nil {
+		feeToken = req.Token
+	}
+	if feeToken == nil {
+		return nil, fmt.Errorf("gasless payment requires a fee token")
+	}
+
+	to := req.To
+	value := req.Amount
+	var data []byte
+
+	if req.Token != nil {
+		parsed, err := abi.JSON(strings.NewReader(erc20ABI))
+		if err != nil {
+			return nil, err
+		}
+		data, err = parsed.Pack("transfer", req.To, req.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode token transfer: %w", err)
+		}
+		to = *req.Token
+		value = big.NewInt(0)
+	}
+
+	signed, err := p.client.SignMetaTx(ctx, MetaTxRequest{
+		From:     p.client.address,
+		To:       to,
+		Value:    value,
+		Data:     data,
+		Deadline: time.Now().Add(metaTxDeadline),
+		FeeToken: *feeToken,
+		MaxFee:   req.MaxFee,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign meta-tx: %w", err)
+	}
+
+	hash, err := p.client.RelayMetaTx(ctx, signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to relay meta-tx: %w", err)
+	}
+
+	return &TransactionResponse{
+		Hash:     hash,
+		From:     p.client.address,
+		To:       &req.To,
+		Amount:   req.Amount,
+		Token:    req.Token,
+		FeePayer: p.client.config.Contracts.Forwarder,
+		FeeToken: *feeToken,
+		Status:   TransactionStatusPending,
+	}, nil
+}
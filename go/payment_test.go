@@ -0,0 +1,115 @@
+package enterl2
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TestPayGasless drives PaymentService.payGasless end-to-end against a
+// stubbed forwarder (for the nonce/chainID reads SignMetaTx needs) and a
+// stub HTTP relayer, and checks that the relayed payload actually carries
+// the request's recipient/amount/fee terms and that FeePayer is populated.
+func TestPayGasless(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	forwarderParsed, err := abi.JSON(strings.NewReader(forwarderABI))
+	if err != nil {
+		t.Fatalf("abi.JSON: %v", err)
+	}
+	packedNonce, err := forwarderParsed.Methods["nonces"].Outputs.Pack(big.NewInt(2))
+	if err != nil {
+		t.Fatalf("Pack nonce: %v", err)
+	}
+
+	backend := &stubBackend{
+		callContractFunc: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+			return packedNonce, nil
+		},
+		networkIDFunc: func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(1337), nil
+		},
+	}
+
+	var capturedBody relayMetaTxPayload
+	relayed := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&capturedBody); err != nil {
+			t.Errorf("decode relay payload: %v", err)
+		}
+		relayed <- struct{}{}
+		_ = json.NewEncoder(w).Encode(relayMetaTxResponse{
+			TxHash: "0x" + strings.Repeat("ab", 32),
+		})
+	}))
+	defer server.Close()
+
+	forwarder := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	feeToken := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	to := common.HexToAddress("0x7777777777777777777777777777777777777777")
+
+	c := &client{
+		config: &Config{
+			RelayerURL: server.URL,
+			Contracts:  ContractAddresses{Forwarder: forwarder},
+		},
+		l2Client:   backend,
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+	p := NewPaymentService(c)
+
+	resp, err := p.payGasless(context.Background(), PaymentRequest{
+		To:       to,
+		Amount:   big.NewInt(1000),
+		FeeToken: &feeToken,
+		MaxFee:   big.NewInt(10),
+	})
+	if err != nil {
+		t.Fatalf("payGasless: %v", err)
+	}
+
+	select {
+	case <-relayed:
+	default:
+		t.Fatalf("relayer was never called")
+	}
+
+	if resp.FeePayer != forwarder {
+		t.Fatalf("FeePayer = %s, want the forwarder address %s", resp.FeePayer.Hex(), forwarder.Hex())
+	}
+	if resp.FeeToken != feeToken {
+		t.Fatalf("FeeToken = %s, want %s", resp.FeeToken.Hex(), feeToken.Hex())
+	}
+	if resp.Status != TransactionStatusPending {
+		t.Fatalf("Status = %v, want TransactionStatusPending", resp.Status)
+	}
+
+	if capturedBody.To != to {
+		t.Fatalf("relayed To = %s, want %s", capturedBody.To.Hex(), to.Hex())
+	}
+	if capturedBody.Value != "1000" {
+		t.Fatalf("relayed Value = %s, want 1000", capturedBody.Value)
+	}
+	if capturedBody.MaxFee != "10" {
+		t.Fatalf("relayed MaxFee = %s, want 10", capturedBody.MaxFee)
+	}
+	if capturedBody.FeeToken != feeToken {
+		t.Fatalf("relayed FeeToken = %s, want %s", capturedBody.FeeToken.Hex(), feeToken.Hex())
+	}
+	if capturedBody.Nonce != "2" {
+		t.Fatalf("relayed Nonce = %s, want 2 (from the stubbed forwarder call)", capturedBody.Nonce)
+	}
+}
@@ -0,0 +1,12 @@
+package enterl2
+
+// StakingService provides access to staking operations on the Enter L2
+// network.
+type StakingService struct {
+	client *client
+}
+
+// NewStakingService creates a new staking service bound to client.
+func NewStakingService(c *client) *StakingService {
+	return &StakingService{client: c}
+}
@@ -0,0 +1,401 @@
+package enterl2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Subscription is a handle to a live event subscription, following the
+// shape of ethereum.Subscription. Dropped connections are retried
+// internally with backoff for as long as the subscription is live, so Err
+// receives nothing while it runs; if Unsubscribe interrupts a pending
+// reconnect, the connection error that caused it is sent on Err. Err is
+// never closed. Unsubscribe stops delivery and releases the underlying
+// websocket subscription.
+type Subscription interface {
+	Err() <-chan error
+	Unsubscribe()
+}
+
+// subscription is the concrete Subscription handle shared by every
+// OnXxx method below.
+type subscription struct {
+	errCh  chan error
+	cancel context.CancelFunc
+	once   sync.Once
+}
+
+func newSubscription() (*subscription, context.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &subscription{errCh: make(chan error, 1), cancel: cancel}, ctx
+}
+
+func (s *subscription) Err() <-chan error { return s.errCh }
+
+func (s *subscription) Unsubscribe() {
+	s.once.Do(s.cancel)
+}
+
+func (s *subscription) fail(err error) {
+	select {
+	case s.errCh <- err:
+	default:
+	}
+	s.cancel()
+}
+
+// subscriptionBackoff is the exponential backoff schedule used to
+// reconnect a dropped websocket subscription.
+var subscriptionBackoff = []time.Duration{
+	1 * time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second, 30 * time.Second,
+}
+
+// SubscriptionsService exposes typed, auto-reconnecting subscriptions to
+// Enter L2 chain events over the client's configured WSURL.
+type SubscriptionsService struct {
+	client *client
+
+	mu     sync.Mutex
+	wsConn *ethclient.Client
+}
+
+// NewSubscriptionsService creates a new subscriptions service bound to
+// client. The websocket connection is dialed lazily on first use.
+func NewSubscriptionsService(c *client) *SubscriptionsService {
+	return &SubscriptionsService{client: c}
+}
+
+// wsClient returns the shared websocket connection, dialing it on first
+// use.
+func (s *SubscriptionsService) wsClient(ctx context.Context) (*ethclient.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wsConn != nil {
+		return s.wsConn, nil
+	}
+	if s.client.config.WSURL == "" {
+		return nil, fmt.Errorf("WSURL is not configured")
+	}
+
+	conn, err := ethclient.DialContext(ctx, s.client.config.WSURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial websocket endpoint: %w", err)
+	}
+	s.wsConn = conn
+	return s.wsConn, nil
+}
+
+// resetWSClient drops the cached websocket connection so the next call to
+// wsClient redials, used when a subscription's connection has gone bad.
+func (s *SubscriptionsService) resetWSClient() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.wsConn != nil {
+		s.wsConn.Close()
+		s.wsConn = nil
+	}
+}
+
+// OnNewHeads streams newly mined block headers.
+func (s *SubscriptionsService) OnNewHeads(ctx context.Context) (<-chan *types.Header, Subscription) {
+	out := make(chan *types.Header)
+	sub, subCtx := newSubscription()
+
+	go func() {
+		defer close(out)
+		attempt := 0
+		for {
+			conn, err := s.wsClient(subCtx)
+			if err != nil {
+				if !s.wait(subCtx, &attempt) {
+					sub.fail(err)
+					return
+				}
+				continue
+			}
+
+			raw := make(chan *types.Header)
+			ethSub, err := conn.SubscribeNewHead(subCtx, raw)
+			if err != nil {
+				s.resetWSClient()
+				if !s.wait(subCtx, &attempt) {
+					sub.fail(err)
+					return
+				}
+				continue
+			}
+
+			attempt = 0
+			if !s.pump(subCtx, ethSub, raw, out) {
+				return
+			}
+			s.resetWSClient()
+		}
+	}()
+
+	return out, sub
+}
+
+// OnLogs streams logs matching filter. On reconnect it first replays, via
+// FilterLogs, logs from the block after the last delivered log onward.
+// Logs later in that last delivered block, logs from before any log was
+// delivered, and logs emitted between the replay and the new subscription
+// are not replayed, and a failed replay query is skipped.
+func (s *SubscriptionsService) OnLogs(ctx context.Context, filter ethereum.FilterQuery) (<-chan types.Log, Subscription) {
+	out := make(chan types.Log)
+	sub, subCtx := newSubscription()
+
+	go func() {
+		defer close(out)
+		attempt := 0
+		var lastSeenBlock *uint64
+
+		for {
+			conn, err := s.wsClient(subCtx)
+			if err != nil {
+				if !s.wait(subCtx, &attempt) {
+					sub.fail(err)
+					return
+				}
+				continue
+			}
+
+			replayFilter := filter
+			if lastSeenBlock != nil {
+				replayFilter.FromBlock = new(big.Int).SetUint64(*lastSeenBlock + 1)
+				missed, err := conn.FilterLogs(subCtx, replayFilter)
+				if err == nil {
+					for _, l := range missed {
+						if !sendLog(subCtx, out, l, &lastSeenBlock) {
+							return
+						}
+					}
+				}
+			}
+
+			raw := make(chan types.Log)
+			ethSub, err := conn.SubscribeFilterLogs(subCtx, filter, raw)
+			if err != nil {
+				s.resetWSClient()
+				if !s.wait(subCtx, &attempt) {
+					sub.fail(err)
+					return
+				}
+				continue
+			}
+
+			attempt = 0
+			if !s.pumpLogs(subCtx, ethSub, raw, out, &lastSeenBlock) {
+				return
+			}
+			s.resetWSClient()
+		}
+	}()
+
+	return out, sub
+}
+
+// pump forwards values from raw to out until the subscription errors or
+// the context is cancelled; it returns false if the caller
+// should stop entirely (context cancelled) and true if it should
+// reconnect.
+func (s *SubscriptionsService) pump(ctx context.Context, ethSub ethereum.Subscription, raw <-chan *types.Header, out chan<- *types.Header) bool {
+	defer ethSub.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case err := <-ethSub.Err():
+			_ = err // transient: fall through to reconnect
+			return true
+		case h := <-raw:
+			select {
+			case out <- h:
+			case <-ctx.Done():
+				return false
+			}
+		}
+	}
+}
+
+func (s *SubscriptionsService) pumpLogs(ctx context.Context, ethSub ethereum.Subscription, raw <-chan types.Log, out chan<- types.Log, lastSeenBlock **uint64) bool {
+	defer ethSub.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case err := <-ethSub.Err():
+			_ = err
+			return true
+		case l := <-raw:
+			if !sendLog(ctx, out, l, lastSeenBlock) {
+				return false
+			}
+		}
+	}
+}
+
+func sendLog(ctx context.Context, out chan<- types.Log, l types.Log, lastSeenBlock **uint64) bool {
+	select {
+	case out <- l:
+		block := l.BlockNumber
+		*lastSeenBlock = &block
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// wait blocks for the next backoff interval, advancing attempt, and
+// returns false if ctx was cancelled first.
+func (s *SubscriptionsService) wait(ctx context.Context, attempt *int) bool {
+	delay := subscriptionBackoff[len(subscriptionBackoff)-1]
+	if *attempt < len(subscriptionBackoff) {
+		delay = subscriptionBackoff[*attempt]
+	}
+	*attempt++
+
+	select {
+	case <-time.After(delay):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// eventTopic computes the topic hash for a Solidity event signature, e.g.
+// "Deposit(address,uint256)".
+func eventTopic(signature string) common.Hash {
+	return crypto.Keccak256Hash([]byte(signature))
+}
+
+// BridgeDepositFilter narrows OnBridgeDeposit to deposits from a specific
+// address; a nil field matches any address.
+type BridgeDepositFilter struct {
+	From *common.Address
+}
+
+// BridgeDepositEvent is a decoded Deposit event.
+type BridgeDepositEvent struct {
+	From   common.Address
+	Amount *big.Int
+	Log    types.Log
+}
+
+var depositTopic = eventTopic("Deposit(address,uint256)")
+
+// OnBridgeDeposit streams Deposit events emitted by the Bridge contract.
+func (s *SubscriptionsService) OnBridgeDeposit(ctx context.Context, filter BridgeDepositFilter) (<-chan *BridgeDepositEvent, Subscription) {
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{s.client.config.Contracts.Bridge},
+		Topics:    [][]common.Hash{{depositTopic}, topicFilter(filter.From)},
+	}
+
+	raw, sub := s.OnLogs(ctx, query)
+	out := make(chan *BridgeDepositEvent)
+	go decodeLogs(raw, out, decodeDepositLog)
+	return out, sub
+}
+
+// NameRegisteredFilter narrows OnNameRegistered to registrations by a
+// specific owner; a nil field matches any address.
+type NameRegisteredFilter struct {
+	Owner *common.Address
+}
+
+// NameRegisteredEvent is a decoded NameRegistered event.
+type NameRegisteredEvent struct {
+	Owner common.Address
+	Name  string
+	Log   types.Log
+}
+
+var nameRegisteredTopic = eventTopic("NameRegistered(address,string)")
+
+// OnNameRegistered streams NameRegistered events emitted by the name
+// registry contract.
+func (s *SubscriptionsService) OnNameRegistered(ctx context.Context, filter NameRegisteredFilter) (<-chan *NameRegisteredEvent, Subscription) {
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{s.client.config.Contracts.NameRegistry},
+		Topics:    [][]common.Hash{{nameRegisteredTopic}, topicFilter(filter.Owner)},
+	}
+
+	raw, sub := s.OnLogs(ctx, query)
+	out := make(chan *NameRegisteredEvent)
+	go decodeLogs(raw, out, decodeNameRegisteredLog)
+	return out, sub
+}
+
+// topicFilter turns an optional address filter into the topic-list shape
+// ethereum.FilterQuery expects: nil (match anything) or a single-element
+// list pinning that topic to addr left-padded to 32 bytes.
+func topicFilter(addr *common.Address) []common.Hash {
+	if addr == nil {
+		return nil
+	}
+	return []common.Hash{common.BytesToHash(addr.Bytes())}
+}
+
+// decodeLogs runs decode over every log on raw, forwarding successfully
+// decoded events to out and closing out once raw closes.
+func decodeLogs[T any](raw <-chan types.Log, out chan<- *T, decode func(types.Log) (*T, error)) {
+	defer close(out)
+	for l := range raw {
+		event, err := decode(l)
+		if err != nil {
+			continue
+		}
+		out <- event
+	}
+}
+
+func decodeDepositLog(l types.Log) (*BridgeDepositEvent, error) {
+	if len(l.Topics) < 2 {
+		return nil, fmt.Errorf("malformed Deposit log")
+	}
+	args, err := abi.Arguments{{Type: mustType("uint256")}}.Unpack(l.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgeDepositEvent{
+		From:   common.BytesToAddress(l.Topics[1].Bytes()),
+		Amount: args[0].(*big.Int),
+		Log:    l,
+	}, nil
+}
+
+func decodeNameRegisteredLog(l types.Log) (*NameRegisteredEvent, error) {
+	if len(l.Topics) < 2 {
+		return nil, fmt.Errorf("malformed NameRegistered log")
+	}
+	args, err := abi.Arguments{{Type: mustType("string")}}.Unpack(l.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &NameRegisteredEvent{
+		Owner: common.BytesToAddress(l.Topics[1].Bytes()),
+		Name:  args[0].(string),
+		Log:   l,
+	}, nil
+}
+
+// mustType panics if name isn't a valid ABI type, used only for the fixed
+// set of types in this file's hand-written event signatures.
+func mustType(name string) abi.Type {
+	t, err := abi.NewType(name, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -0,0 +1,122 @@
+package enterl2
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestTopicFilter(t *testing.T) {
+	if got := topicFilter(nil); got != nil {
+		t.Fatalf("topicFilter(nil) = %v, want nil", got)
+	}
+
+	addr := common.HexToAddress("0x1")
+	got := topicFilter(&addr)
+	want := common.BytesToHash(addr.Bytes())
+	if len(got) != 1 || got[0] != want {
+		t.Fatalf("topicFilter(&addr) = %v, want [%v]", got, want)
+	}
+}
+
+func TestDecodeDepositLog(t *testing.T) {
+	from := common.HexToAddress("0xABCDEF")
+	amount := big.NewInt(42)
+
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		t.Fatalf("abi.NewType: %v", err)
+	}
+	data, err := abi.Arguments{{Type: uint256Type}}.Pack(amount)
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+
+	event, err := decodeDepositLog(types.Log{
+		Topics: []common.Hash{depositTopic, common.BytesToHash(from.Bytes())},
+		Data:   data,
+	})
+	if err != nil {
+		t.Fatalf("decodeDepositLog: %v", err)
+	}
+	if event.From != from {
+		t.Fatalf("From = %s, want %s", event.From.Hex(), from.Hex())
+	}
+	if event.Amount.Cmp(amount) != 0 {
+		t.Fatalf("Amount = %v, want %v", event.Amount, amount)
+	}
+}
+
+func TestDecodeDepositLogMalformed(t *testing.T) {
+	if _, err := decodeDepositLog(types.Log{Topics: []common.Hash{depositTopic}}); err == nil {
+		t.Fatalf("decodeDepositLog: want an error for a log with no from topic")
+	}
+}
+
+func TestDecodeNameRegisteredLog(t *testing.T) {
+	owner := common.HexToAddress("0xBEEF00")
+	name := "alice.l2"
+
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatalf("abi.NewType: %v", err)
+	}
+	data, err := abi.Arguments{{Type: stringType}}.Pack(name)
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+
+	event, err := decodeNameRegisteredLog(types.Log{
+		Topics: []common.Hash{nameRegisteredTopic, common.BytesToHash(owner.Bytes())},
+		Data:   data,
+	})
+	if err != nil {
+		t.Fatalf("decodeNameRegisteredLog: %v", err)
+	}
+	if event.Owner != owner {
+		t.Fatalf("Owner = %s, want %s", event.Owner.Hex(), owner.Hex())
+	}
+	if event.Name != name {
+		t.Fatalf("Name = %q, want %q", event.Name, name)
+	}
+}
+
+func TestDecodeNameRegisteredLogMalformed(t *testing.T) {
+	if _, err := decodeNameRegisteredLog(types.Log{Topics: []common.Hash{nameRegisteredTopic}}); err == nil {
+		t.Fatalf("decodeNameRegisteredLog: want an error for a log with no owner topic")
+	}
+}
+
+func TestWaitReturnsFalseOnCancelledContext(t *testing.T) {
+	s := &SubscriptionsService{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempt := 0
+	if s.wait(ctx, &attempt) {
+		t.Fatalf("wait returned true for an already-cancelled context")
+	}
+}
+
+func TestWaitAdvancesAttemptAndFallsBackPastScheduleEnd(t *testing.T) {
+	orig := subscriptionBackoff
+	subscriptionBackoff = []time.Duration{time.Millisecond, 2 * time.Millisecond}
+	defer func() { subscriptionBackoff = orig }()
+
+	s := &SubscriptionsService{}
+	attempt := 0
+
+	for i := 1; i <= 3; i++ {
+		if !s.wait(context.Background(), &attempt) {
+			t.Fatalf("wait(#%d) returned false, want true", i)
+		}
+		if attempt != i {
+			t.Fatalf("attempt after wait #%d = %d, want %d", i, attempt, i)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package enterl2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	cmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
+)
+
+// TokenService provides ERC20 token operations (balances, allowances,
+// transfers, and EIP-2612 permits) against arbitrary token contracts,
+// caching metadata (symbol/decimals/name) per token address so repeated
+// calls don't re-fetch it.
+type TokenService struct {
+	client *client
+
+	mu       sync.RWMutex
+	metadata map[common.Address]*tokenMetadata
+}
+
+// tokenMetadata is the cached, immutable on-chain metadata for a token.
+type tokenMetadata struct {
+	Symbol   string
+	Name     string
+	Decimals uint8
+}
+
+// NewTokenService creates a new token service bound to client.
+func NewTokenService(c *client) *TokenService {
+	return &TokenService{
+		client:   c,
+		metadata: make(map[common.Address]*tokenMetadata),
+	}
+}
+
+// warmCache pre-registers any known token addresses (USDC, USDT) from the
+// client's configured contracts, so the first real call to BalanceOf or
+// FormatAmount doesn't pay the metadata round-trip.
+func (t *TokenService) warmCache(ctx context.Context) {
+	for _, addr := range []common.Address{t.client.config.Contracts.USDC, t.client.config.Contracts.USDT} {
+		if addr == (common.Address{}) {
+			continue
+		}
+		if _, err := t.metadataFor(ctx, addr); err != nil {
+			continue
+		}
+	}
+}
+
+func (t *TokenService) boundContract(token common.Address) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(erc20ABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ERC20 ABI: %w", err)
+	}
+	return bind.NewBoundContract(token, parsed, t.client.l2Client, t.client.l2Client, t.client.l2Client), nil
+}
+
+// metadataFor returns the cached symbol/name/decimals for token, fetching
+// and caching them on first use.
+func (t *TokenService) metadataFor(ctx context.Context, token common.Address) (*tokenMetadata, error) {
+	t.mu.RLock()
+	meta, ok := t.metadata[token]
+	t.mu.RUnlock()
+	if ok {
+		return meta, nil
+	}
+
+	contract, err := t.boundContract(token)
+	if err != nil {
+		return nil, err
+	}
+	callOpts := t.client.GetCallOpts(ctx)
+
+	var symbolOut, nameOut []interface{}
+	if err := contract.Call(callOpts, &symbolOut, "symbol"); err != nil {
+		return nil, fmt.Errorf("failed to read symbol: %w", err)
+	}
+	if err := contract.Call(callOpts, &nameOut, "name"); err != nil {
+		return nil, fmt.Errorf("failed to read name: %w", err)
+	}
+	var decimalsOut []interface{}
+	if err := contract.Call(callOpts, &decimalsOut, "decimals"); err != nil {
+		return nil, fmt.Errorf("failed to read decimals: %w", err)
+	}
+
+	meta = &tokenMetadata{
+		Symbol:   *abi.ConvertType(symbolOut[0], new(string)).(*string),
+		Name:     *abi.ConvertType(nameOut[0], new(string)).(*string),
+		Decimals: *abi.ConvertType(decimalsOut[0], new(uint8)).(*uint8),
+	}
+
+	t.mu.Lock()
+	t.metadata[token] = meta
+	t.mu.Unlock()
+
+	return meta, nil
+}
+
+// BalanceOf returns holder's balance of token, populated with its cached
+// symbol and decimals.
+func (t *TokenService) BalanceOf(ctx context.Context, token, holder common.Address) (*TokenBalance, error) {
+	meta, err := t.metadataFor(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := t.boundContract(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []interface{}
+	if err := contract.Call(t.client.GetCallOpts(ctx), &out, "balanceOf", holder); err != nil {
+		return nil, fmt.Errorf("failed to read balance: %w", err)
+	}
+
+	return &TokenBalance{
+		Token:    token,
+		Symbol:   meta.Symbol,
+		Decimals: meta.Decimals,
+		Balance:  *abi.ConvertType(out[0], new(*big.Int)).(**big.Int),
+	}, nil
+}
+
+// Allowance returns the amount spender is allowed to transfer on behalf of
+// owner for token.
+func (t *TokenService) Allowance(ctx context.Context, token, owner, spender common.Address) (*big.Int, error) {
+	contract, err := t.boundContract(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []interface{}
+	if err := contract.Call(t.client.GetCallOpts(ctx), &out, "allowance", owner, spender); err != nil {
+		return nil, fmt.Errorf("failed to read allowance: %w", err)
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// Approve authorizes spender to transfer up to amount of token on behalf
+// of the connected account. Approve does not itself move funds, so it is
+// not subject to WalletService's whitelist/daily-limit checks.
+func (t *TokenService) Approve(ctx context.Context, token, spender common.Address, amount *big.Int) (*TransactionResponse, error) {
+	return t.transact(ctx, token, "approve", spender, amount)
+}
+
+// Transfer sends amount of token from the connected account to to,
+// enforcing the connected account's whitelist/daily-limit the same way
+// PaymentService.Pay does (see WalletService.preflightCheck) — calling
+// Transfer directly does not bypass those controls.
+func (t *TokenService) Transfer(ctx context.Context, token, to common.Address, amount *big.Int) (*TransactionResponse, error) {
+	if err := t.client.wallet.preflightCheck(ctx, t.client.address, to, amount); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transact(ctx, token, "transfer", to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	t.client.wallet.commitSpend(t.client.address, amount)
+	return resp, nil
+}
+
+// TransferFrom moves amount of token from from to to, drawing on an
+// existing allowance granted to the connected account, enforcing from's
+// whitelist/daily-limit exactly as Transfer does.
+func (t *TokenService) TransferFrom(ctx context.Context, token, from, to common.Address, amount *big.Int) (*TransactionResponse, error) {
+	if err := t.client.wallet.preflightCheck(ctx, from, to, amount); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transact(ctx, token, "transferFrom", from, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	t.client.wallet.commitSpend(from, amount)
+	return resp, nil
+}
+
+func (t *TokenService) transact(ctx context.Context, token common.Address, method string, args ...interface{}) (*TransactionResponse, error) {
+	contract, err := t.boundContract(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.client.Execute(ctx, func(ctx context.Context, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.Transact(opts, method, args...)
+	})
+}
+
+// PermitRequest describes an EIP-2612 off-chain approval to be signed by
+// the connected account and submitted as a permit() call.
+type PermitRequest struct {
+	Token    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Deadline time.Time
+}
+
+// Permit signs an EIP-2612 typed-data permit with the connected account's
+// private key and submits it to token in a permit() call sent from the
+// connected account, approving req.Spender for req.Value.
+func (t *TokenService) Permit(ctx context.Context, req PermitRequest) (*TransactionResponse, error) {
+	if !t.client.IsConnected() {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	meta, err := t.metadataFor(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := t.boundContract(req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonceOut []interface{}
+	if err := contract.Call(t.client.GetCallOpts(ctx), &nonceOut, "nonces", t.client.address); err != nil {
+		return nil, fmt.Errorf("failed to read permit nonce: %w", err)
+	}
+	nonce := *abi.ConvertType(nonceOut[0], new(*big.Int)).(**big.Int)
+
+	chainID, err := t.client.l2Client.NetworkID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain: apitypes.TypedDataDomain{
+			Name:              meta.Name,
+			Version:           "1",
+			ChainId:           (*cmath.HexOrDecimal256)(chainID),
+			VerifyingContract: req.Token.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"owner":    t.client.address.Hex(),
+			"spender":  req.Spender.Hex(),
+			"value":    req.Value.String(),
+			"nonce":    nonce.String(),
+			"deadline": big.NewInt(req.Deadline.Unix()).String(),
+		},
+	}
+
+	digest, err := typedDataHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash permit typed data: %w", err)
+	}
+
+	sig, err := crypto.Sign(digest, t.client.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign permit: %w", err)
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	v := sig[64] + 27
+
+	return t.client.Execute(ctx, func(ctx context.Context, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.Transact(opts, "permit", t.client.address, req.Spender, req.Value,
+			big.NewInt(req.Deadline.Unix()), v, common.BigToHash(r), common.BigToHash(s))
+	})
+}
+
+// typedDataHash computes the EIP-712 signing hash for typedData:
+// keccak256("\x19\x01" || domainSeparator || hashStruct(message)).
+func typedDataHash(typedData apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, err
+	}
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, err
+	}
+	raw := append([]byte{0x19, 0x01}, append(domainSeparator, messageHash...)...)
+	return crypto.Keccak256(raw), nil
+}
+
+// FormatAmount renders raw as a human-readable decimal.Decimal using
+// token's cached decimals, without a redundant metadata fetch per call.
+func (t *TokenService) FormatAmount(ctx context.Context, token common.Address, raw *big.Int) (decimal.Decimal, error) {
+	meta, err := t.metadataFor(ctx, token)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return decimal.NewFromBigInt(raw, -int32(meta.Decimals)), nil
+}
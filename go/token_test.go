@@ -0,0 +1,109 @@
+package enterl2
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestTokenServiceFormatAmount(t *testing.T) {
+	ts := NewTokenService(&client{})
+	token := common.HexToAddress("0x1")
+	ts.metadata[token] = &tokenMetadata{Symbol: "USDC", Name: "USD Coin", Decimals: 6}
+
+	got, err := ts.FormatAmount(context.Background(), token, big.NewInt(1500000))
+	if err != nil {
+		t.Fatalf("FormatAmount: %v", err)
+	}
+	if got.String() != "1.5" {
+		t.Fatalf("FormatAmount = %s, want 1.5", got.String())
+	}
+}
+
+func TestTokenServiceMetadataForCachesResult(t *testing.T) {
+	erc20Parsed, err := abi.JSON(strings.NewReader(erc20ABI))
+	if err != nil {
+		t.Fatalf("abi.JSON: %v", err)
+	}
+
+	calls := 0
+	backend := &stubBackend{
+		callContractFunc: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+			calls++
+			method, err := erc20Parsed.MethodById(call.Data[:4])
+			if err != nil {
+				return nil, err
+			}
+			switch method.Name {
+			case "symbol":
+				return method.Outputs.Pack("USDC")
+			case "name":
+				return method.Outputs.Pack("USD Coin")
+			case "decimals":
+				return method.Outputs.Pack(uint8(6))
+			default:
+				t.Fatalf("unexpected call to %s", method.Name)
+				return nil, nil
+			}
+		},
+	}
+
+	ts := NewTokenService(&client{l2Client: backend, address: common.HexToAddress("0xCAFE")})
+	token := common.HexToAddress("0x1")
+
+	meta, err := ts.metadataFor(context.Background(), token)
+	if err != nil {
+		t.Fatalf("metadataFor: %v", err)
+	}
+	if meta.Symbol != "USDC" || meta.Name != "USD Coin" || meta.Decimals != 6 {
+		t.Fatalf("metadataFor = %+v, want {USDC USD Coin 6}", meta)
+	}
+	if calls != 3 {
+		t.Fatalf("calls = %d, want 3 (symbol, name, decimals)", calls)
+	}
+
+	if _, err := ts.metadataFor(context.Background(), token); err != nil {
+		t.Fatalf("metadataFor (cached): %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("calls after cached fetch = %d, want still 3 (metadataFor must not re-fetch)", calls)
+	}
+}
+
+// TestTokenServiceWarmCacheStopsOnCancelledContext checks that warmCache
+// gives up on a cancelled context instead of retrying/blocking forever,
+// the property NewClientWithBackend relies on to bound warmCache's
+// goroutine to Close() via a cancellable context.
+func TestTokenServiceWarmCacheStopsOnCancelledContext(t *testing.T) {
+	backend := &stubBackend{
+		callContractFunc: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+			return nil, ctx.Err()
+		},
+	}
+
+	ts := NewTokenService(&client{
+		l2Client: backend,
+		config:   &Config{Contracts: ContractAddresses{USDC: common.HexToAddress("0x1")}},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		ts.warmCache(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("warmCache did not return once ctx was already cancelled")
+	}
+}
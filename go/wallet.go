@@ -0,0 +1,299 @@
+package enterl2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Additional EnterL2Error codes specific to wallet-level security checks.
+const (
+	ErrCodeDailyLimitExceeded = "DAILY_LIMIT_EXCEEDED"
+	ErrCodeNotWhitelisted     = "NOT_WHITELISTED"
+)
+
+// walletInfoCacheTTL bounds how long a fetched WalletInfo is trusted
+// before Client.SendTransaction/PaymentService.Pay re-fetch it.
+const walletInfoCacheTTL = 30 * time.Second
+
+// walletABI is the ABI surface of a consumer/merchant smart-contract
+// wallet deployed by WalletFactory.
+const walletABI = `[
+	{"constant":true,"inputs":[],"name":"walletType","outputs":[{"name":"","type":"uint8"}],"type":"function"},
+	{"constant":true,"inputs":[],"name":"owner","outputs":[{"name":"","type":"address"}],"type":"function"},
+	{"constant":true,"inputs":[],"name":"whitelistEnabled","outputs":[{"name":"","type":"bool"}],"type":"function"},
+	{"constant":true,"inputs":[],"name":"dailyLimit","outputs":[{"name":"","type":"uint256"}],"type":"function"},
+	{"constant":true,"inputs":[],"name":"getOperators","outputs":[{"name":"","type":"address[]"}],"type":"function"},
+	{"constant":true,"inputs":[{"name":"addr","type":"address"}],"name":"isWhitelisted","outputs":[{"name":"","type":"bool"}],"type":"function"},
+	{"constant":false,"inputs":[{"name":"addr","type":"address"}],"name":"addToWhitelist","outputs":[],"type":"function"},
+	{"constant":false,"inputs":[{"name":"addr","type":"address"}],"name":"removeFromWhitelist","outputs":[],"type":"function"},
+	{"constant":false,"inputs":[{"name":"limit","type":"uint256"}],"name":"setDailyLimit","outputs":[],"type":"function"},
+	{"constant":false,"inputs":[{"name":"operator","type":"address"}],"name":"addOperator","outputs":[],"type":"function"},
+	{"constant":false,"inputs":[{"name":"operator","type":"address"}],"name":"removeOperator","outputs":[],"type":"function"}
+]`
+
+// cachedWalletInfo is a WalletInfo together with when it was fetched, so
+// GetWalletInfo can serve repeated pre-flight checks without re-reading
+// the chain every time.
+type cachedWalletInfo struct {
+	info      *WalletInfo
+	fetchedAt time.Time
+}
+
+// WalletService provides access to smart-contract wallet management
+// operations (whitelisting, daily spending limits, operators), and
+// enforces those limits client-side before Client.SendTransaction and
+// PaymentService.Pay submit a transaction.
+type WalletService struct {
+	client *client
+
+	infoMu    sync.Mutex
+	infoCache map[common.Address]*cachedWalletInfo
+
+	spendMu    sync.Mutex
+	spentToday map[string]*big.Int
+}
+
+// NewWalletService creates a new wallet service bound to client.
+func NewWalletService(c *client) *WalletService {
+	return &WalletService{
+		client:     c,
+		infoCache:  make(map[common.Address]*cachedWalletInfo),
+		spentToday: make(map[string]*big.Int),
+	}
+}
+
+func (w *WalletService) boundContract(wallet common.Address) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(walletABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse wallet ABI: %w", err)
+	}
+	return bind.NewBoundContract(wallet, parsed, w.client.l2Client, w.client.l2Client, w.client.l2Client), nil
+}
+
+// GetWalletInfo returns wallet's on-chain configuration, using a cached
+// copy if it was fetched within walletInfoCacheTTL. An address that is
+// not a deployed smart-contract wallet (e.g. a plain EOA) returns an
+// error, since there is no wallet contract to read.
+func (w *WalletService) GetWalletInfo(ctx context.Context, wallet common.Address) (*WalletInfo, error) {
+	w.infoMu.Lock()
+	cached, ok := w.infoCache[wallet]
+	w.infoMu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < walletInfoCacheTTL {
+		return cached.info, nil
+	}
+
+	info, err := w.fetchWalletInfo(ctx, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	w.infoMu.Lock()
+	w.infoCache[wallet] = &cachedWalletInfo{info: info, fetchedAt: time.Now()}
+	w.infoMu.Unlock()
+
+	return info, nil
+}
+
+func (w *WalletService) fetchWalletInfo(ctx context.Context, wallet common.Address) (*WalletInfo, error) {
+	contract, err := w.boundContract(wallet)
+	if err != nil {
+		return nil, err
+	}
+	callOpts := w.client.GetCallOpts(ctx)
+
+	var walletTypeOut, ownerOut, whitelistOut, limitOut, operatorsOut []interface{}
+	if err := contract.Call(callOpts, &walletTypeOut, "walletType"); err != nil {
+		return nil, fmt.Errorf("failed to read wallet type: %w", err)
+	}
+	if err := contract.Call(callOpts, &ownerOut, "owner"); err != nil {
+		return nil, fmt.Errorf("failed to read wallet owner: %w", err)
+	}
+	if err := contract.Call(callOpts, &whitelistOut, "whitelistEnabled"); err != nil {
+		return nil, fmt.Errorf("failed to read whitelistEnabled: %w", err)
+	}
+	if err := contract.Call(callOpts, &limitOut, "dailyLimit"); err != nil {
+		return nil, fmt.Errorf("failed to read dailyLimit: %w", err)
+	}
+	if err := contract.Call(callOpts, &operatorsOut, "getOperators"); err != nil {
+		return nil, fmt.Errorf("failed to read operators: %w", err)
+	}
+
+	return &WalletInfo{
+		Address:          wallet,
+		Type:             WalletType(*abi.ConvertType(walletTypeOut[0], new(uint8)).(*uint8)),
+		Owner:            *abi.ConvertType(ownerOut[0], new(common.Address)).(*common.Address),
+		WhitelistEnabled: *abi.ConvertType(whitelistOut[0], new(bool)).(*bool),
+		DailyLimit:       *abi.ConvertType(limitOut[0], new(*big.Int)).(**big.Int),
+		Operators:        *abi.ConvertType(operatorsOut[0], new([]common.Address)).(*[]common.Address),
+	}, nil
+}
+
+func (w *WalletService) isWhitelisted(ctx context.Context, wallet, addr common.Address) (bool, error) {
+	contract, err := w.boundContract(wallet)
+	if err != nil {
+		return false, err
+	}
+	var out []interface{}
+	if err := contract.Call(w.client.GetCallOpts(ctx), &out, "isWhitelisted", addr); err != nil {
+		return false, fmt.Errorf("failed to read whitelist status: %w", err)
+	}
+	return *abi.ConvertType(out[0], new(bool)).(*bool), nil
+}
+
+// isContractWallet reports whether wallet has deployed contract code,
+// distinguishing a plain EOA (nothing to enforce) from a real
+// smart-contract wallet whose WalletInfo must be honored.
+func (w *WalletService) isContractWallet(ctx context.Context, wallet common.Address) (bool, error) {
+	code, err := w.client.l2Client.CodeAt(ctx, wallet, nil)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// preflightCheck enforces wallet's whitelist and daily spending limit
+// before a transfer of amount to to is submitted. It is a no-op for
+// addresses that aren't WalletTypeConsumer smart-contract wallets
+// (including plain EOAs), but a genuine failure to read wallet state
+// (RPC/network errors) is propagated rather than swallowed, since
+// failing open would let an over-limit or non-whitelisted transfer
+// through undetected.
+//
+// preflightCheck only checks the daily limit; it does not reserve
+// amount against it. Callers that get a nil error back and go on to
+// actually submit the transfer must call commitSpend once the send
+// succeeds, so a transfer that fails after the check (RPC hiccup, gas
+// estimation error, revert, ...) never permanently eats into the
+// wallet's remaining budget for the day.
+func (w *WalletService) preflightCheck(ctx context.Context, wallet, to common.Address, amount *big.Int) error {
+	isContract, err := w.isContractWallet(ctx, wallet)
+	if err != nil {
+		return fmt.Errorf("failed to check wallet contract code: %w", err)
+	}
+	if !isContract {
+		return nil
+	}
+
+	info, err := w.GetWalletInfo(ctx, wallet)
+	if err != nil {
+		return fmt.Errorf("failed to read wallet info: %w", err)
+	}
+	if info.Type != WalletTypeConsumer {
+		return nil
+	}
+
+	if info.WhitelistEnabled {
+		whitelisted, err := w.isWhitelisted(ctx, wallet, to)
+		if err != nil {
+			return err
+		}
+		if !whitelisted {
+			return NewEnterL2Error(fmt.Sprintf("recipient %s is not whitelisted", to.Hex()), ErrCodeNotWhitelisted, to)
+		}
+	}
+
+	if amount != nil && info.DailyLimit != nil && info.DailyLimit.Sign() > 0 {
+		if spent, exceeded := w.wouldExceedDailyLimit(wallet, amount, info.DailyLimit); exceeded {
+			return NewEnterL2Error("daily spending limit exceeded", ErrCodeDailyLimitExceeded, spent)
+		}
+	}
+
+	return nil
+}
+
+// wouldExceedDailyLimit reports whether adding amount to wallet's recorded
+// spend for the current UTC day would exceed limit. It only peeks at the
+// running total; see commitSpend for actually recording a spend.
+func (w *WalletService) wouldExceedDailyLimit(wallet common.Address, amount, limit *big.Int) (*big.Int, bool) {
+	w.spendMu.Lock()
+	defer w.spendMu.Unlock()
+
+	next := new(big.Int).Add(w.spentTodayLocked(wallet), amount)
+	return next, next.Cmp(limit) > 0
+}
+
+// commitSpend adds amount to wallet's running total for the current UTC
+// day. Callers must only call this after the transaction that spends
+// amount has actually been submitted (see preflightCheck).
+func (w *WalletService) commitSpend(wallet common.Address, amount *big.Int) {
+	if amount == nil {
+		return
+	}
+
+	w.spendMu.Lock()
+	defer w.spendMu.Unlock()
+	w.spentToday[spendKey(wallet)] = new(big.Int).Add(w.spentTodayLocked(wallet), amount)
+}
+
+// spentTodayLocked returns wallet's recorded spend for the current UTC
+// day, or zero if nothing has been recorded yet. Callers must hold
+// spendMu.
+func (w *WalletService) spentTodayLocked(wallet common.Address) *big.Int {
+	if spent := w.spentToday[spendKey(wallet)]; spent != nil {
+		return spent
+	}
+	return big.NewInt(0)
+}
+
+// spendKey is the spentToday map key for wallet's spend on the current
+// UTC day.
+func spendKey(wallet common.Address) string {
+	return wallet.Hex() + "|" + time.Now().UTC().Format("2006-01-02")
+}
+
+// AddToWhitelist authorizes addr to receive transfers from wallet when
+// wallet has whitelisting enabled.
+func (w *WalletService) AddToWhitelist(ctx context.Context, wallet, addr common.Address) (*TransactionResponse, error) {
+	return w.transact(ctx, wallet, "addToWhitelist", addr)
+}
+
+// RemoveFromWhitelist revokes addr's authorization to receive transfers
+// from wallet.
+func (w *WalletService) RemoveFromWhitelist(ctx context.Context, wallet, addr common.Address) (*TransactionResponse, error) {
+	return w.transact(ctx, wallet, "removeFromWhitelist", addr)
+}
+
+// SetDailyLimit sets wallet's maximum aggregate spend per UTC day.
+func (w *WalletService) SetDailyLimit(ctx context.Context, wallet common.Address, limit *big.Int) (*TransactionResponse, error) {
+	return w.transact(ctx, wallet, "setDailyLimit", limit)
+}
+
+// AddOperator authorizes operator to act on behalf of wallet.
+func (w *WalletService) AddOperator(ctx context.Context, wallet, operator common.Address) (*TransactionResponse, error) {
+	return w.transact(ctx, wallet, "addOperator", operator)
+}
+
+// RemoveOperator revokes operator's authorization to act on behalf of
+// wallet.
+func (w *WalletService) RemoveOperator(ctx context.Context, wallet, operator common.Address) (*TransactionResponse, error) {
+	return w.transact(ctx, wallet, "removeOperator", operator)
+}
+
+func (w *WalletService) transact(ctx context.Context, wallet common.Address, method string, args ...interface{}) (*TransactionResponse, error) {
+	contract, err := w.boundContract(wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := w.client.Execute(ctx, func(ctx context.Context, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.Transact(opts, method, args...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	w.infoMu.Lock()
+	delete(w.infoCache, wallet)
+	w.infoMu.Unlock()
+
+	return resp, nil
+}
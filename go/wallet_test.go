@@ -0,0 +1,42 @@
+package enterl2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestWalletServiceWouldExceedDailyLimitAndCommitSpend(t *testing.T) {
+	w := NewWalletService(nil)
+	wallet := common.HexToAddress("0x1")
+	limit := big.NewInt(100)
+
+	// Checking must not itself record anything: calling it repeatedly with
+	// the same amount must not accumulate.
+	if _, exceeded := w.wouldExceedDailyLimit(wallet, big.NewInt(40), limit); exceeded {
+		t.Fatalf("first check: got exceeded=true, want false")
+	}
+	if _, exceeded := w.wouldExceedDailyLimit(wallet, big.NewInt(40), limit); exceeded {
+		t.Fatalf("repeated check: got exceeded=true, want false (wouldExceedDailyLimit must not record)")
+	}
+
+	w.commitSpend(wallet, big.NewInt(40))
+	spent, exceeded := w.wouldExceedDailyLimit(wallet, big.NewInt(60), limit)
+	if exceeded || spent.Cmp(limit) != 0 {
+		t.Fatalf("spend to exact limit: got spent=%v exceeded=%v, want spent=100 exceeded=false", spent, exceeded)
+	}
+	w.commitSpend(wallet, big.NewInt(60))
+
+	if spent, exceeded := w.wouldExceedDailyLimit(wallet, big.NewInt(1), limit); !exceeded {
+		t.Fatalf("spend beyond limit: got exceeded=false, want true (spent=%v)", spent)
+	}
+
+	// The rejected spend above must never have been committed: a
+	// transaction that fails after preflightCheck's limit check passes
+	// must not permanently reduce the wallet's remaining budget.
+	spent, exceeded = w.wouldExceedDailyLimit(wallet, big.NewInt(0), limit)
+	if exceeded || spent.Cmp(limit) != 0 {
+		t.Fatalf("after uncommitted spend: got spent=%v exceeded=%v, want spent still 100", spent, exceeded)
+	}
+}